@@ -0,0 +1,288 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package quat
+
+// A Ring is the arithmetic surface shared by every value type this
+// package can double under the Cayley-Dickson construction. T is the
+// value type, and the interface is satisfied by *T, following the usual
+// Go idiom for expressing "the method set of the pointer type".
+//
+// Hamilton satisfies Ring[Hamilton], so Elliptic[Hamilton, *Hamilton] (the
+// Octonion) can in turn satisfy Ring[Octonion], letting the construction
+// be iterated to build the Sedenion.
+type Ring[T any] interface {
+	*T
+	Add(x, y *T) *T
+	Mul(x, y *T) *T
+	Conj(y *T) *T
+	Neg(y *T) *T
+	Quad() float64
+	Copy(y *T) *T
+	Equals(y *T) bool
+}
+
+// An Elliptic is the Cayley-Dickson doubling of T under the elliptic
+// multiplication rule
+// 		(a,b)(c,d) = (ac − d*b, da + bc*)
+// the rule that doubles Hamilton into Octonion and Octonion into Sedenion.
+type Elliptic[T any, PT Ring[T]] struct {
+	re, im T
+}
+
+// Re returns a pointer to the Cayley-Dickson real part of z.
+func (z *Elliptic[T, PT]) Re() *T { return &z.re }
+
+// Im returns a pointer to the Cayley-Dickson imaginary part of z.
+func (z *Elliptic[T, PT]) Im() *T { return &z.im }
+
+// Equals returns true if y and z are equal.
+func (z *Elliptic[T, PT]) Equals(y *Elliptic[T, PT]) bool {
+	return PT(&z.re).Equals(&y.re) && PT(&z.im).Equals(&y.im)
+}
+
+// Copy copies y onto z, and returns z.
+func (z *Elliptic[T, PT]) Copy(y *Elliptic[T, PT]) *Elliptic[T, PT] {
+	PT(&z.re).Copy(&y.re)
+	PT(&z.im).Copy(&y.im)
+	return z
+}
+
+// Neg sets z equal to the negative of y, and returns z.
+func (z *Elliptic[T, PT]) Neg(y *Elliptic[T, PT]) *Elliptic[T, PT] {
+	PT(&z.re).Neg(&y.re)
+	PT(&z.im).Neg(&y.im)
+	return z
+}
+
+// Add sets z equal to the sum of x and y, and returns z.
+func (z *Elliptic[T, PT]) Add(x, y *Elliptic[T, PT]) *Elliptic[T, PT] {
+	PT(&z.re).Add(&x.re, &y.re)
+	PT(&z.im).Add(&x.im, &y.im)
+	return z
+}
+
+// Conj sets z equal to the conjugate of y, and returns z.
+func (z *Elliptic[T, PT]) Conj(y *Elliptic[T, PT]) *Elliptic[T, PT] {
+	PT(&z.re).Conj(&y.re)
+	PT(&z.im).Neg(&y.im)
+	return z
+}
+
+// Quad returns the non-negative quadrance of z.
+func (z *Elliptic[T, PT]) Quad() float64 {
+	return PT(&z.re).Quad() + PT(&z.im).Quad()
+}
+
+// Mul sets z equal to the product of x and y, using the elliptic doubling
+// rule (a,b)(c,d) = (ac − d*b, da + bc*), and returns z.
+func (z *Elliptic[T, PT]) Mul(x, y *Elliptic[T, PT]) *Elliptic[T, PT] {
+	var a, b, c, d T
+	PT(&a).Copy(&x.re)
+	PT(&b).Copy(&x.im)
+	PT(&c).Copy(&y.re)
+	PT(&d).Copy(&y.im)
+
+	var dConj, cConj T
+	PT(&dConj).Conj(&d)
+	PT(&cConj).Conj(&c)
+
+	var ac, dConjB, negDConjB, re T
+	PT(&ac).Mul(&a, &c)
+	PT(&dConjB).Mul(&dConj, &b)
+	PT(&negDConjB).Neg(&dConjB)
+	PT(&re).Add(&ac, &negDConjB)
+
+	var da, bcConj, im T
+	PT(&da).Mul(&d, &a)
+	PT(&bcConj).Mul(&b, &cConj)
+	PT(&im).Add(&da, &bcConj)
+
+	PT(&z.re).Copy(&re)
+	PT(&z.im).Copy(&im)
+	return z
+}
+
+// A Parabolic is the Cayley-Dickson doubling of T under the parabolic
+// multiplication rule
+// 		(a,b)(c,d) = (ac, da + bc*)
+type Parabolic[T any, PT Ring[T]] struct {
+	re, im T
+}
+
+// Re returns a pointer to the Cayley-Dickson real part of z.
+func (z *Parabolic[T, PT]) Re() *T { return &z.re }
+
+// Im returns a pointer to the Cayley-Dickson imaginary part of z.
+func (z *Parabolic[T, PT]) Im() *T { return &z.im }
+
+// Equals returns true if y and z are equal.
+func (z *Parabolic[T, PT]) Equals(y *Parabolic[T, PT]) bool {
+	return PT(&z.re).Equals(&y.re) && PT(&z.im).Equals(&y.im)
+}
+
+// Copy copies y onto z, and returns z.
+func (z *Parabolic[T, PT]) Copy(y *Parabolic[T, PT]) *Parabolic[T, PT] {
+	PT(&z.re).Copy(&y.re)
+	PT(&z.im).Copy(&y.im)
+	return z
+}
+
+// Neg sets z equal to the negative of y, and returns z.
+func (z *Parabolic[T, PT]) Neg(y *Parabolic[T, PT]) *Parabolic[T, PT] {
+	PT(&z.re).Neg(&y.re)
+	PT(&z.im).Neg(&y.im)
+	return z
+}
+
+// Add sets z equal to the sum of x and y, and returns z.
+func (z *Parabolic[T, PT]) Add(x, y *Parabolic[T, PT]) *Parabolic[T, PT] {
+	PT(&z.re).Add(&x.re, &y.re)
+	PT(&z.im).Add(&x.im, &y.im)
+	return z
+}
+
+// Conj sets z equal to the conjugate of y, and returns z.
+func (z *Parabolic[T, PT]) Conj(y *Parabolic[T, PT]) *Parabolic[T, PT] {
+	PT(&z.re).Conj(&y.re)
+	PT(&z.im).Neg(&y.im)
+	return z
+}
+
+// Quad returns the non-negative quadrance of z.
+func (z *Parabolic[T, PT]) Quad() float64 {
+	return PT(&z.re).Quad()
+}
+
+// Mul sets z equal to the product of x and y, using the parabolic
+// doubling rule (a,b)(c,d) = (ac, da + bc*), and returns z.
+func (z *Parabolic[T, PT]) Mul(x, y *Parabolic[T, PT]) *Parabolic[T, PT] {
+	var a, b, c, d T
+	PT(&a).Copy(&x.re)
+	PT(&b).Copy(&x.im)
+	PT(&c).Copy(&y.re)
+	PT(&d).Copy(&y.im)
+
+	var cConj T
+	PT(&cConj).Conj(&c)
+
+	var re T
+	PT(&re).Mul(&a, &c)
+
+	var da, bcConj, im T
+	PT(&da).Mul(&d, &a)
+	PT(&bcConj).Mul(&b, &cConj)
+	PT(&im).Add(&da, &bcConj)
+
+	PT(&z.re).Copy(&re)
+	PT(&z.im).Copy(&im)
+	return z
+}
+
+// A Hyperbolic is the Cayley-Dickson doubling of T under the hyperbolic
+// multiplication rule
+// 		(a,b)(c,d) = (ac + d*b, da + bc*)
+// the rule that, applied to the split quaternions, gives the split-octonions.
+type Hyperbolic[T any, PT Ring[T]] struct {
+	re, im T
+}
+
+// Re returns a pointer to the Cayley-Dickson real part of z.
+func (z *Hyperbolic[T, PT]) Re() *T { return &z.re }
+
+// Im returns a pointer to the Cayley-Dickson imaginary part of z.
+func (z *Hyperbolic[T, PT]) Im() *T { return &z.im }
+
+// Equals returns true if y and z are equal.
+func (z *Hyperbolic[T, PT]) Equals(y *Hyperbolic[T, PT]) bool {
+	return PT(&z.re).Equals(&y.re) && PT(&z.im).Equals(&y.im)
+}
+
+// Copy copies y onto z, and returns z.
+func (z *Hyperbolic[T, PT]) Copy(y *Hyperbolic[T, PT]) *Hyperbolic[T, PT] {
+	PT(&z.re).Copy(&y.re)
+	PT(&z.im).Copy(&y.im)
+	return z
+}
+
+// Neg sets z equal to the negative of y, and returns z.
+func (z *Hyperbolic[T, PT]) Neg(y *Hyperbolic[T, PT]) *Hyperbolic[T, PT] {
+	PT(&z.re).Neg(&y.re)
+	PT(&z.im).Neg(&y.im)
+	return z
+}
+
+// Add sets z equal to the sum of x and y, and returns z.
+func (z *Hyperbolic[T, PT]) Add(x, y *Hyperbolic[T, PT]) *Hyperbolic[T, PT] {
+	PT(&z.re).Add(&x.re, &y.re)
+	PT(&z.im).Add(&x.im, &y.im)
+	return z
+}
+
+// Conj sets z equal to the conjugate of y, and returns z.
+func (z *Hyperbolic[T, PT]) Conj(y *Hyperbolic[T, PT]) *Hyperbolic[T, PT] {
+	PT(&z.re).Conj(&y.re)
+	PT(&z.im).Neg(&y.im)
+	return z
+}
+
+// Quad returns the quadrance of z. Unlike Elliptic and Parabolic, this can
+// be negative, since the doubling adjoins a basis element that squares to
+// +1 rather than -1.
+func (z *Hyperbolic[T, PT]) Quad() float64 {
+	return PT(&z.re).Quad() - PT(&z.im).Quad()
+}
+
+// Mul sets z equal to the product of x and y, using the hyperbolic
+// doubling rule (a,b)(c,d) = (ac + d*b, da + bc*), and returns z.
+func (z *Hyperbolic[T, PT]) Mul(x, y *Hyperbolic[T, PT]) *Hyperbolic[T, PT] {
+	var a, b, c, d T
+	PT(&a).Copy(&x.re)
+	PT(&b).Copy(&x.im)
+	PT(&c).Copy(&y.re)
+	PT(&d).Copy(&y.im)
+
+	var dConj, cConj T
+	PT(&dConj).Conj(&d)
+	PT(&cConj).Conj(&c)
+
+	var ac, dConjB, re T
+	PT(&ac).Mul(&a, &c)
+	PT(&dConjB).Mul(&dConj, &b)
+	PT(&re).Add(&ac, &dConjB)
+
+	var da, bcConj, im T
+	PT(&da).Mul(&d, &a)
+	PT(&bcConj).Mul(&b, &cConj)
+	PT(&im).Add(&da, &bcConj)
+
+	PT(&z.re).Copy(&re)
+	PT(&z.im).Copy(&im)
+	return z
+}
+
+// Octonion is the elliptic Cayley-Dickson doubling of Hamilton, the
+// eight-dimensional, non-associative algebra built the same way Hamilton
+// doubles complex128.
+type Octonion = Elliptic[Hamilton, *Hamilton]
+
+// Sedenion is the elliptic Cayley-Dickson doubling of Octonion, the
+// sixteen-dimensional algebra in which even the alternative-algebra
+// properties Octonion retains are lost.
+type Sedenion = Elliptic[Octonion, *Octonion]
+
+// Associator returns the associator of x, y, and z,
+// 		(xy)z − x(yz)
+// which vanishes identically in an associative algebra but not, in
+// general, once the Cayley-Dickson doubling is iterated past the
+// quaternions.
+func Associator[T any, PT Ring[T]](x, y, z *T) *T {
+	var xy, xyz, yz, xyz2, negXyz2, a T
+	PT(&xy).Mul(x, y)
+	PT(&xyz).Mul(&xy, z)
+	PT(&yz).Mul(y, z)
+	PT(&xyz2).Mul(x, &yz)
+	PT(&negXyz2).Neg(&xyz2)
+	PT(&a).Add(&xyz, &negXyz2)
+	return &a
+}
@@ -0,0 +1,86 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package quat
+
+import "testing"
+
+func newOctonion(a, b, c, d, e, f, g, h float64) *Octonion {
+	o := new(Octonion)
+	o.Re().Copy(NewHamilton(a, b, c, d))
+	o.Im().Copy(NewHamilton(e, f, g, h))
+	return o
+}
+
+func TestEllipticQuadMultiplicative(t *testing.T) {
+	x := newOctonion(1, 2, 3, 4, 5, 6, 7, 8)
+	y := newOctonion(-2, 1, 0, 3, 1, -1, 2, 2)
+
+	got := new(Octonion).Mul(x, y).Quad()
+	want := x.Quad() * y.Quad()
+	if !closeEnough(got, want) {
+		t.Errorf("Quad(Mul(x, y)) = %v, want Quad(x)*Quad(y) = %v", got, want)
+	}
+}
+
+func TestEllipticConjMul(t *testing.T) {
+	x := newOctonion(1, -2, 3, 0, 2, 1, -1, 4)
+	got := new(Octonion).Mul(x, new(Octonion).Conj(x)).Quad()
+	want := x.Quad() * x.Quad()
+	if !closeEnough(got, want) {
+		t.Errorf("Mul(x, Conj(x)).Quad() = %v, want Quad(x)^2 = %v", got, want)
+	}
+}
+
+func TestAssociatorVanishesForHamilton(t *testing.T) {
+	x := NewHamilton(1, 2, 3, 4)
+	y := NewHamilton(0, 1, -1, 2)
+	z := NewHamilton(-1, 0, 2, 1)
+
+	a := Associator[Hamilton, *Hamilton](x, y, z)
+	if !a.Equals(zeroH) {
+		t.Errorf("Associator(x, y, z) = %v, want zero; Hamilton multiplication is associative", a)
+	}
+}
+
+func TestAssociatorNonzeroForOctonion(t *testing.T) {
+	// The standard octonion basis elements e1, e2, e4 associate to a
+	// nonzero value; this witnesses that Octonion, unlike Hamilton, is
+	// not associative.
+	e1 := newOctonion(0, 1, 0, 0, 0, 0, 0, 0)
+	e2 := newOctonion(0, 0, 1, 0, 0, 0, 0, 0)
+	e4 := newOctonion(0, 0, 0, 0, 1, 0, 0, 0)
+
+	a := Associator[Octonion, *Octonion](e1, e2, e4)
+	if a.Equals(new(Octonion)) {
+		t.Errorf("Associator(e1, e2, e4) = %v, want nonzero; Octonion multiplication is non-associative", a)
+	}
+}
+
+func TestParabolicMulRealPart(t *testing.T) {
+	var x, y Parabolic[Hamilton, *Hamilton]
+	x.Re().Copy(NewHamilton(1, 2, 0, 0))
+	x.Im().Copy(NewHamilton(0, 1, 0, 0))
+	y.Re().Copy(NewHamilton(2, 0, 1, 0))
+	y.Im().Copy(NewHamilton(1, 0, 0, 1))
+
+	var z Parabolic[Hamilton, *Hamilton]
+	z.Mul(&x, &y)
+
+	want := new(Hamilton).Mul(x.Re(), y.Re())
+	if !z.Re().Equals(want) {
+		t.Errorf("Parabolic Mul real part = %v, want ac = %v", z.Re(), want)
+	}
+}
+
+func TestHyperbolicQuadCanBeNegative(t *testing.T) {
+	var z Hyperbolic[Hamilton, *Hamilton]
+	z.Re().Copy(NewHamilton(1, 0, 0, 0))
+	z.Im().Copy(NewHamilton(2, 0, 0, 0))
+
+	got := z.Quad()
+	want := 1.0 - 4.0
+	if !closeEnough(got, want) {
+		t.Errorf("Hyperbolic Quad() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,280 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+// Package dualquat implements dual quaternions, the standard encoding of a
+// rigid body transformation (a rotation together with a translation) as a
+// single algebraic object built on top of the Hamilton quaternion.
+package dualquat
+
+import (
+	"fmt"
+	"math"
+
+	quat "github.com/meirizarrygelpi/qtr"
+)
+
+var zeroH = quat.NewHamilton(0, 0, 0, 0)
+
+// A DualHamilton represents a dual quaternion q_r + εq_d as an ordered
+// pair of Hamilton quaternions, the real part q_r and the dual part q_d,
+// with ε² = 0.
+type DualHamilton [2]quat.Hamilton
+
+// Real returns a pointer to the real part of z, a Hamilton quaternion.
+func (z *DualHamilton) Real() *quat.Hamilton {
+	return &z[0]
+}
+
+// Dual returns a pointer to the dual part of z, a Hamilton quaternion.
+func (z *DualHamilton) Dual() *quat.Hamilton {
+	return &z[1]
+}
+
+// SetReal sets the real part of z equal to a given Hamilton quaternion.
+func (z *DualHamilton) SetReal(r *quat.Hamilton) {
+	z[0] = *r
+}
+
+// SetDual sets the dual part of z equal to a given Hamilton quaternion.
+func (z *DualHamilton) SetDual(d *quat.Hamilton) {
+	z[1] = *d
+}
+
+// NewDualHamilton returns a pointer to a DualHamilton value made from two
+// given Hamilton quaternions, the real and dual parts.
+func NewDualHamilton(r, d *quat.Hamilton) *DualHamilton {
+	z := new(DualHamilton)
+	z.SetReal(r)
+	z.SetDual(d)
+	return z
+}
+
+// String returns the string representation of a DualHamilton value. If z
+// corresponds to q_r + εq_d, then the string is "(q_r+εq_d)".
+func (z *DualHamilton) String() string {
+	return fmt.Sprintf("(%v+ε%v)", z.Real(), z.Dual())
+}
+
+// Equals returns true if y and z are equal.
+func (z *DualHamilton) Equals(y *DualHamilton) bool {
+	return z.Real().Equals(y.Real()) && z.Dual().Equals(y.Dual())
+}
+
+// Copy copies y onto z, and returns z.
+func (z *DualHamilton) Copy(y *DualHamilton) *DualHamilton {
+	z.SetReal(y.Real())
+	z.SetDual(y.Dual())
+	return z
+}
+
+// Add sets z equal to the sum of x and y, and returns z.
+func (z *DualHamilton) Add(x, y *DualHamilton) *DualHamilton {
+	z.SetReal(new(quat.Hamilton).Add(x.Real(), y.Real()))
+	z.SetDual(new(quat.Hamilton).Add(x.Dual(), y.Dual()))
+	return z
+}
+
+// Sub sets z equal to the difference of x and y, and returns z.
+func (z *DualHamilton) Sub(x, y *DualHamilton) *DualHamilton {
+	z.SetReal(new(quat.Hamilton).Sub(x.Real(), y.Real()))
+	z.SetDual(new(quat.Hamilton).Sub(x.Dual(), y.Dual()))
+	return z
+}
+
+// Mul sets z equal to the product of x and y, and returns z.
+//
+// The multiplication rule, following from ε² = 0, is:
+// 		(a+εb)(c+εd) = ac + ε(ad+bc)
+func (z *DualHamilton) Mul(x, y *DualHamilton) *DualHamilton {
+	a, b := x.Real(), x.Dual()
+	c, d := y.Real(), y.Dual()
+	re := new(quat.Hamilton).Mul(a, c)
+	du := new(quat.Hamilton).Add(
+		new(quat.Hamilton).Mul(a, d),
+		new(quat.Hamilton).Mul(b, c),
+	)
+	z.SetReal(re)
+	z.SetDual(du)
+	return z
+}
+
+// Conj sets z equal to the quaternion conjugate of y, conjugating both the
+// real and dual parts, and returns z.
+func (z *DualHamilton) Conj(y *DualHamilton) *DualHamilton {
+	z.SetReal(new(quat.Hamilton).Conj(y.Real()))
+	z.SetDual(new(quat.Hamilton).Conj(y.Dual()))
+	return z
+}
+
+// DualConj sets z equal to the dual conjugate of y, negating the dual
+// part, and returns z.
+func (z *DualHamilton) DualConj(y *DualHamilton) *DualHamilton {
+	z.SetReal(y.Real())
+	z.SetDual(new(quat.Hamilton).Neg(y.Dual()))
+	return z
+}
+
+// FullConj sets z equal to the combined conjugate of y, both the
+// quaternion conjugate and the dual conjugate, and returns z.
+func (z *DualHamilton) FullConj(y *DualHamilton) *DualHamilton {
+	z.SetReal(new(quat.Hamilton).Conj(y.Real()))
+	z.SetDual(new(quat.Hamilton).Neg(new(quat.Hamilton).Conj(y.Dual())))
+	return z
+}
+
+// dot returns the Euclidean dot product of x and y, treating each as a
+// 4-vector of its Cartesian components.
+func dot(x, y *quat.Hamilton) float64 {
+	xa, xb, xc, xd := x.Cartesian()
+	ya, yb, yc, yd := y.Cartesian()
+	return xa*ya + xb*yb + xc*yc + xd*yd
+}
+
+// Norm returns the dual number (real, dual) representing the norm of z,
+// the Quad of the real part together with its first-order dual
+// correction.
+func (z *DualHamilton) Norm() (real, dual float64) {
+	real = z.Real().Quad()
+	dual = 2 * dot(z.Real(), z.Dual())
+	return
+}
+
+// Inv sets z equal to the inverse of y, and returns z. If the real part of
+// y is zero, then Inv panics.
+func (z *DualHamilton) Inv(y *DualHamilton) *DualHamilton {
+	if y.Real().Equals(zeroH) {
+		panic("inverse of dual quaternion with zero real part")
+	}
+	rInv := new(quat.Hamilton).Inv(y.Real())
+	dInv := new(quat.Hamilton).Neg(
+		new(quat.Hamilton).Mul(rInv, new(quat.Hamilton).Mul(y.Dual(), rInv)),
+	)
+	z.SetReal(rInv)
+	z.SetDual(dInv)
+	return z
+}
+
+func normalize(v [3]float64) [3]float64 {
+	n := math.Sqrt(v[0]*v[0] + v[1]*v[1] + v[2]*v[2])
+	if n == 0 {
+		return v
+	}
+	return [3]float64{v[0] / n, v[1] / n, v[2] / n}
+}
+
+// NewFromRotation returns a pointer to a unit DualHamilton value
+// representing a pure rotation by angle radians about axis, which need
+// not be normalized.
+func NewFromRotation(axis [3]float64, angle float64) *DualHamilton {
+	n := normalize(axis)
+	s, c := math.Sin(angle/2), math.Cos(angle/2)
+	r := quat.NewHamilton(c, n[0]*s, n[1]*s, n[2]*s)
+	return NewDualHamilton(r, new(quat.Hamilton))
+}
+
+// NewFromTranslation returns a pointer to a unit DualHamilton value
+// representing a pure translation by (x, y, z).
+func NewFromTranslation(x, y, z float64) *DualHamilton {
+	r := quat.NewHamilton(1, 0, 0, 0)
+	t := quat.NewHamilton(0, x, y, z)
+	d := new(quat.Hamilton).Dil(new(quat.Hamilton).Mul(t, r), 0.5)
+	return NewDualHamilton(r, d)
+}
+
+// NewFromRigid returns a pointer to a unit DualHamilton value that encodes
+// the rigid transformation which rotates by rot and then translates by
+// translation, with the dual part q_d = 0.5·t·q_r, where t is the pure
+// quaternion (0, translation).
+func NewFromRigid(rot *quat.Hamilton, translation [3]float64) *DualHamilton {
+	t := quat.NewHamilton(0, translation[0], translation[1], translation[2])
+	d := new(quat.Hamilton).Dil(new(quat.Hamilton).Mul(t, rot), 0.5)
+	return NewDualHamilton(rot, d)
+}
+
+// TransformPoint applies the rigid transformation encoded by the unit
+// dual quaternion z to the point p, and returns the transformed point.
+func (z *DualHamilton) TransformPoint(p [3]float64) [3]float64 {
+	qr, qd := z.Real(), z.Dual()
+	rInv := new(quat.Hamilton).Conj(qr)
+
+	t := new(quat.Hamilton).Dil(new(quat.Hamilton).Mul(qd, rInv), 2)
+	_, tx, ty, tz := t.Cartesian()
+
+	v := quat.NewHamilton(0, p[0], p[1], p[2])
+	rotated := new(quat.Hamilton).Mul(qr, new(quat.Hamilton).Mul(v, rInv))
+	_, rx, ry, rz := rotated.Cartesian()
+
+	return [3]float64{rx + tx, ry + ty, rz + tz}
+}
+
+// screw decomposes the unit dual quaternion z into its screw parameters:
+// the rotation angle, the unit axis of rotation, the pitch (translation
+// along the axis), and the moment vector locating the axis in space. If z
+// is a pure (or near-pure) translation, pure is true and translation holds
+// that translation directly, since the axis/moment parameterization is
+// degenerate (and, critically, not invertible by fromScrew) when there is
+// no rotation to anchor it to.
+func screw(z *DualHamilton) (angle float64, axis [3]float64, pitch float64, moment [3]float64, pure bool, translation [3]float64) {
+	rw, rx, ry, rz := z.Real().Cartesian()
+	dw, dx, dy, dz := z.Dual().Cartesian()
+
+	s := math.Sqrt(rx*rx + ry*ry + rz*rz)
+	angle = 2 * math.Atan2(s, rw)
+
+	if s < 1e-12 {
+		return 0, [3]float64{0, 0, 1}, 0, [3]float64{}, true, [3]float64{2 * dx, 2 * dy, 2 * dz}
+	}
+
+	axis = [3]float64{rx / s, ry / s, rz / s}
+	pitch = -2 * dw / s
+	moment = [3]float64{
+		(dx - pitch/2*rw*axis[0]) / s,
+		(dy - pitch/2*rw*axis[1]) / s,
+		(dz - pitch/2*rw*axis[2]) / s,
+	}
+	return
+}
+
+// fromScrew builds the unit dual quaternion representing the screw motion
+// with the given angle, axis, pitch, and moment vector.
+func fromScrew(angle float64, axis [3]float64, pitch float64, moment [3]float64) *DualHamilton {
+	s, c := math.Sin(angle/2), math.Cos(angle/2)
+	r := quat.NewHamilton(c, axis[0]*s, axis[1]*s, axis[2]*s)
+	d := quat.NewHamilton(
+		-pitch/2*s,
+		moment[0]*s+pitch/2*c*axis[0],
+		moment[1]*s+pitch/2*c*axis[1],
+		moment[2]*s+pitch/2*c*axis[2],
+	)
+	return NewDualHamilton(r, d)
+}
+
+// fromTranslation builds the unit dual quaternion representing a pure
+// translation by t·translation, the scaled step used by ScLERP when the
+// relative motion has no rotation component.
+func fromTranslation(t float64, translation [3]float64) *DualHamilton {
+	r := quat.NewHamilton(1, 0, 0, 0)
+	d := new(quat.Hamilton).Dil(
+		quat.NewHamilton(0, translation[0], translation[1], translation[2]), t/2,
+	)
+	return NewDualHamilton(r, d)
+}
+
+// ScLERP returns the screw linear interpolation between the unit dual
+// quaternions a and b at t ∈ [0, 1], tracing the constant-speed screw
+// motion that carries the pose a to the pose b.
+func ScLERP(a, b *DualHamilton, t float64) *DualHamilton {
+	diff := new(DualHamilton).Mul(new(DualHamilton).Conj(a), b)
+	angle, axis, pitch, moment, pure, translation := screw(diff)
+
+	var step *DualHamilton
+	if pure {
+		// The axis/moment parameterization collapses to zero whenever
+		// angle=0 (fromScrew scales moment by sin(angle/2)), so a pure
+		// translation has to be interpolated directly instead.
+		step = fromTranslation(t, translation)
+	} else {
+		step = fromScrew(angle*t, axis, pitch*t, moment)
+	}
+	return new(DualHamilton).Mul(a, step)
+}
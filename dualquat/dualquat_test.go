@@ -0,0 +1,101 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package dualquat
+
+import (
+	"math"
+	"testing"
+
+	quat "github.com/meirizarrygelpi/qtr"
+)
+
+const epsilon = 1e-6
+
+func closeEnough(a, b float64) bool {
+	return math.Abs(a-b) < epsilon
+}
+
+func pointCloseEnough(p, q [3]float64) bool {
+	return closeEnough(p[0], q[0]) && closeEnough(p[1], q[1]) && closeEnough(p[2], q[2])
+}
+
+func TestNewFromTranslation(t *testing.T) {
+	z := NewFromTranslation(1, 2, 3)
+	got := z.TransformPoint([3]float64{0, 0, 0})
+	want := [3]float64{1, 2, 3}
+	if !pointCloseEnough(got, want) {
+		t.Errorf("TransformPoint(origin) = %v, want %v", got, want)
+	}
+}
+
+func TestNewFromRotation(t *testing.T) {
+	z := NewFromRotation([3]float64{0, 0, 1}, math.Pi/2)
+	got := z.TransformPoint([3]float64{1, 0, 0})
+	want := [3]float64{0, 1, 0}
+	if !pointCloseEnough(got, want) {
+		t.Errorf("TransformPoint((1,0,0)) = %v, want %v", got, want)
+	}
+}
+
+func TestNewFromRigid(t *testing.T) {
+	rot := quat.NewHamilton(math.Cos(math.Pi/4), 0, 0, math.Sin(math.Pi/4))
+	z := NewFromRigid(rot, [3]float64{1, 0, 0})
+	got := z.TransformPoint([3]float64{1, 0, 0})
+	// Rotate (1,0,0) by 90° about z to get (0,1,0), then translate by (1,0,0).
+	want := [3]float64{1, 1, 0}
+	if !pointCloseEnough(got, want) {
+		t.Errorf("TransformPoint((1,0,0)) = %v, want %v", got, want)
+	}
+}
+
+func TestInv(t *testing.T) {
+	z := NewFromRigid(
+		quat.NewHamilton(math.Cos(math.Pi/6), 0, math.Sin(math.Pi/6), 0),
+		[3]float64{1, 2, 3},
+	)
+	inv := new(DualHamilton).Inv(z)
+	got := new(DualHamilton).Mul(z, inv)
+
+	gr, gi, gj, gk := got.Real().Cartesian()
+	if !closeEnough(gr, 1) || !closeEnough(gi, 0) || !closeEnough(gj, 0) || !closeEnough(gk, 0) {
+		t.Errorf("Mul(z, Inv(z)) real part = %v, want identity", got.Real())
+	}
+	dr, di, dj, dk := got.Dual().Cartesian()
+	if !closeEnough(dr, 0) || !closeEnough(di, 0) || !closeEnough(dj, 0) || !closeEnough(dk, 0) {
+		t.Errorf("Mul(z, Inv(z)) dual part = %v, want zero", got.Dual())
+	}
+}
+
+func TestScLERPEndpoints(t *testing.T) {
+	a := NewFromTranslation(0, 0, 0)
+	b := NewFromRotation([3]float64{0, 0, 1}, math.Pi/2)
+
+	gotA := ScLERP(a, b, 0)
+	if !pointCloseEnough(
+		gotA.TransformPoint([3]float64{1, 0, 0}),
+		a.TransformPoint([3]float64{1, 0, 0}),
+	) {
+		t.Errorf("ScLERP(a, b, 0) does not transform points like a")
+	}
+
+	gotB := ScLERP(a, b, 1)
+	if !pointCloseEnough(
+		gotB.TransformPoint([3]float64{1, 0, 0}),
+		b.TransformPoint([3]float64{1, 0, 0}),
+	) {
+		t.Errorf("ScLERP(a, b, 1) does not transform points like b")
+	}
+}
+
+func TestScLERPPureTranslationMidpoint(t *testing.T) {
+	a := NewFromTranslation(0, 0, 0)
+	b := NewFromTranslation(2, 0, 0)
+
+	mid := ScLERP(a, b, 0.5)
+	got := mid.TransformPoint([3]float64{0, 0, 0})
+	want := [3]float64{1, 0, 0}
+	if !pointCloseEnough(got, want) {
+		t.Errorf("ScLERP(a, b, 0.5).TransformPoint(origin) = %v, want %v", got, want)
+	}
+}
@@ -195,7 +195,18 @@ func (z *Hamilton) Sub(x, y *Hamilton) *Hamilton {
 // 		Mul(i, j) = -Mul(j, i) = +k
 // 		Mul(j, k) = -Mul(k, j) = +i
 // 		Mul(k, i) = -Mul(i, k) = +j
+//
+// If x or y has an infinite component, Mul follows Annex G of the C99
+// standard: the operand is first treated as a directed infinity (its NaN
+// components, if any, are replaced by signed zeros), and the result is the
+// quaternionic infinity with the corresponding sign pattern, rather than
+// the NaN that the naive formula above would produce. Components whose sign
+// is undetermined are NaN, and if no component's sign is determined (as for
+// an infinity times zero), the result is HamiltonNaN.
 func (z *Hamilton) Mul(x, y *Hamilton) *Hamilton {
+	if x.IsInf() || y.IsInf() {
+		return z.Copy(mulInf(x, y))
+	}
 	p := new(Hamilton).Copy(x)
 	q := new(Hamilton).Copy(y)
 	z.SetRe(
@@ -209,31 +220,108 @@ func (z *Hamilton) Mul(x, y *Hamilton) *Hamilton {
 	return z
 }
 
+// signOf classifies a component for directed-infinity arithmetic: an
+// infinite component keeps only its sign, a NaN component is treated as
+// having no direction (Annex G's "replace NaN with a signed zero"), a zero
+// component stays zero, and any other finite component keeps its ordinary
+// sign.
+func signOf(v float64) float64 {
+	switch {
+	case math.IsNaN(v):
+		return 0
+	case v > 0:
+		return 1
+	case v < 0:
+		return -1
+	default:
+		return 0
+	}
+}
+
+// mulInf returns the product of x and y, at least one of which has an
+// infinite component, as a quaternionic infinity (or a partial one, with
+// NaN in components whose sign cannot be determined), per Annex G.
+//
+// Rather than running the ordinary complex128 formula (which still
+// produces NaN from Inf-Inf and Inf*0 terms internally), each output
+// component's sign is derived symbolically: every input component is
+// reduced to its sign via signOf, and the quaternion multiplication
+// formula is applied to those signs directly, so no arithmetic is ever
+// performed on an actual Inf or NaN value.
+func mulInf(x, y *Hamilton) *Hamilton {
+	xa, xb, xc, xd := x.Cartesian()
+	ya, yb, yc, yd := y.Cartesian()
+	a0, a1, a2, a3 := signOf(xa), signOf(xb), signOf(xc), signOf(xd)
+	b0, b1, b2, b3 := signOf(ya), signOf(yb), signOf(yc), signOf(yd)
+
+	re := a0*b0 - a1*b1 - a2*b2 - a3*b3
+	im1 := a0*b1 + a1*b0 + a2*b3 - a3*b2
+	im2 := a0*b2 - a1*b3 + a2*b0 + a3*b1
+	im3 := a0*b3 + a1*b2 - a2*b1 + a3*b0
+
+	if re == 0 && im1 == 0 && im2 == 0 && im3 == 0 {
+		return HamiltonNaN()
+	}
+	comp := func(s float64) float64 {
+		if s == 0 {
+			return math.NaN()
+		}
+		return math.Inf(int(s))
+	}
+	return NewHamilton(comp(re), comp(im1), comp(im2), comp(im3))
+}
+
 // Commutator sets z equal to the commutator of x and y, and returns z.
 func (z *Hamilton) Commutator(x, y *Hamilton) *Hamilton {
 	return z.Sub(new(Hamilton).Mul(x, y), new(Hamilton).Mul(y, x))
 }
 
-// Quad returns the non-negative quadrance of z.
+// Quad returns the non-negative quadrance of z. If z has an infinite
+// component, Quad is +Inf, even if another component is NaN.
 func (z *Hamilton) Quad() float64 {
+	if z.IsInf() {
+		return math.Inf(1)
+	}
 	a, b := cmplx.Abs(z.Re()), cmplx.Abs(z.Im())
 	return (a * a) + (b * b)
 }
 
-// Inv sets z equal to the inverse of y, and returns z. If y is zero, then Inv
-// panics.
+// Inv sets z equal to the inverse of y, and returns z.
+//
+// Following Annex G, Inv(zero) is HamiltonInf and Inv(HamiltonInf) is
+// zero, rather than a panic or a NaN quaternion.
 func (z *Hamilton) Inv(y *Hamilton) *Hamilton {
 	if y.Equals(zeroH) {
-		panic("inverse of zero")
+		return z.Copy(HamiltonInf(1, 1, 1, 1))
+	}
+	if y.IsInf() {
+		return z.Copy(zeroH)
 	}
 	return z.Dil(new(Hamilton).Conj(y), 1/y.Quad())
 }
 
-// Quo sets z equal to the quotient of x and y, and returns z. If y is zero,
-// then Quo panics.
+// Quo sets z equal to the quotient of x and y, and returns z.
+//
+// Following Annex G: Quo(nonzero, zero) and Quo(HamiltonInf, finite) are
+// quaternionic infinities with the sign pattern of x*Inv(y) (derived
+// symbolically via mulInf, not computed by dividing by zero), Quo(zero,
+// zero) and Quo(HamiltonInf, HamiltonInf) are HamiltonNaN, and
+// Quo(finite, HamiltonInf) is zero.
 func (z *Hamilton) Quo(x, y *Hamilton) *Hamilton {
 	if y.Equals(zeroH) {
-		panic("denominator is zero")
+		if x.Equals(zeroH) {
+			return z.Copy(HamiltonNaN())
+		}
+		return z.Copy(mulInf(x, HamiltonInf(1, 1, 1, 1)))
+	}
+	if y.IsInf() {
+		if x.IsInf() {
+			return z.Copy(HamiltonNaN())
+		}
+		return z.Copy(zeroH)
+	}
+	if x.IsInf() {
+		return z.Copy(mulInf(x, new(Hamilton).Inv(y)))
 	}
 	return z.Dil(new(Hamilton).Mul(x, new(Hamilton).Conj(y)), 1/y.Quad())
 }
@@ -256,11 +344,43 @@ func RectHamilton(r, θ1, θ2, θ3 float64) *Hamilton {
 	return zeroH
 }
 
+// signProxy returns a finite Hamilton approximating the direction of z: an
+// infinite component collapses to its sign and a NaN component collapses
+// to zero, so that the angles derived from it are those of a finite value
+// even when z itself has an infinite component.
+func signProxy(z *Hamilton) *Hamilton {
+	a, b, c, d := z.Cartesian()
+	proxy := func(v float64) float64 {
+		switch {
+		case math.IsInf(v, 0):
+			return math.Copysign(1, v)
+		case math.IsNaN(v):
+			return 0
+		default:
+			return v
+		}
+	}
+	return NewHamilton(proxy(a), proxy(b), proxy(c), proxy(d))
+}
+
 // Curv returns the curvilinear coordinates of a Hamilton value.
+//
+// If z has an infinite component, r is +Inf and the angles are those of
+// signProxy(z), derived from the direction of z (per Annex G). As for a
+// finite value, θ2 is NaN when that direction lies along the real axis.
 func (z *Hamilton) Curv() (r, θ1, θ2, θ3 float64) {
 	if z.Equals(zeroH) {
 		return 0, math.NaN(), math.NaN(), math.NaN()
 	}
+	if z.IsInf() {
+		d := signProxy(z)
+		h := cmplx.Abs(d.Im())
+		r = math.Inf(1)
+		θ1 = math.Atan(math.Hypot(imag(d.Re()), h) / real(d.Re()))
+		θ2 = math.Atan(h / imag(d[0]))
+		θ3 = math.Atan2(imag(d.Im()), real(d.Im()))
+		return
+	}
 	h := cmplx.Abs(z.Im())
 	r = math.Sqrt(z.Quad())
 	θ1 = math.Atan(math.Hypot(imag(z.Re()), h) / real(z.Re()))
@@ -0,0 +1,107 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package quat
+
+import (
+	"math"
+	"testing"
+)
+
+func TestMulInfFiniteNonzero(t *testing.T) {
+	x := HamiltonInf(1, 1, 1, 1)
+	y := NewHamilton(2, 3, 4, 5)
+	z := new(Hamilton).Mul(x, y)
+	if z.IsNaN() {
+		t.Fatalf("Mul(%v, %v) = %v, want a quaternionic infinity, not NaN", x, y, z)
+	}
+	if !z.IsInf() {
+		t.Fatalf("Mul(%v, %v) = %v, want a quaternionic infinity", x, y, z)
+	}
+	a, b, c, d := z.Cartesian()
+	want := [4]float64{-1, 1, 1, 1}
+	got := [4]float64{a, b, c, d}
+	for i, w := range want {
+		if math.Signbit(got[i]) != math.Signbit(w) {
+			t.Errorf("Mul(%v, %v)[%d] sign = %v, want sign of %v", x, y, i, got[i], w)
+		}
+	}
+}
+
+func TestMulInfWithZeroComponent(t *testing.T) {
+	x := HamiltonInf(1, 1, 1, 1)
+	y := NewHamilton(2, 0, 0, 0)
+	z := new(Hamilton).Mul(x, y)
+	if !z.IsInf() {
+		t.Fatalf("Mul(%v, %v) = %v, want a quaternionic infinity", x, y, z)
+	}
+}
+
+func TestMulInfIndeterminate(t *testing.T) {
+	x := HamiltonInf(1, 1, 1, 1)
+	y := zeroH
+	z := new(Hamilton).Mul(x, y)
+	if !z.IsNaN() {
+		t.Errorf("Mul(%v, %v) = %v, want HamiltonNaN", x, y, z)
+	}
+}
+
+func TestInvZeroAndInf(t *testing.T) {
+	inv := new(Hamilton).Inv(zeroH)
+	if !inv.IsInf() {
+		t.Errorf("Inv(zero) = %v, want a quaternionic infinity", inv)
+	}
+	z := new(Hamilton).Inv(HamiltonInf(1, 1, 1, 1))
+	if !z.Equals(zeroH) {
+		t.Errorf("Inv(HamiltonInf) = %v, want zero", z)
+	}
+}
+
+func TestQuoInfDirection(t *testing.T) {
+	x := HamiltonInf(1, 1, 1, 1)
+	y := NewHamilton(5, 0, 0, 0)
+	z := new(Hamilton).Quo(x, y)
+	if z.IsNaN() {
+		t.Fatalf("Quo(%v, %v) = %v, want a quaternionic infinity, not NaN", x, y, z)
+	}
+	if !z.IsInf() {
+		t.Fatalf("Quo(%v, %v) = %v, want a quaternionic infinity", x, y, z)
+	}
+
+	w := NewHamilton(0, 5, 0, 0)
+	z2 := new(Hamilton).Quo(x, w)
+	if z.Equals(z2) {
+		t.Errorf("Quo(%v, %v) and Quo(%v, %v) gave the same directed infinity %v; expected the sign pattern to track y", x, y, x, w, z)
+	}
+}
+
+func TestQuoByZero(t *testing.T) {
+	x := NewHamilton(1, 2, 3, 4)
+	z := new(Hamilton).Quo(x, zeroH)
+	if !z.IsInf() {
+		t.Errorf("Quo(%v, zero) = %v, want a quaternionic infinity", x, z)
+	}
+	if !new(Hamilton).Quo(zeroH, zeroH).IsNaN() {
+		t.Errorf("Quo(zero, zero) should be HamiltonNaN")
+	}
+}
+
+func TestQuadInfinite(t *testing.T) {
+	z := NewHamilton(math.Inf(1), math.NaN(), 0, 0)
+	if got := z.Quad(); !math.IsInf(got, 1) {
+		t.Errorf("Quad(%v) = %v, want +Inf", z, got)
+	}
+}
+
+func TestCurvInfinite(t *testing.T) {
+	z := HamiltonInf(1, 1, 1, 1)
+	r, θ1, θ2, θ3 := z.Curv()
+	if !math.IsInf(r, 1) {
+		t.Fatalf("Curv(%v) r = %v, want +Inf", z, r)
+	}
+	for i, θ := range []float64{θ1, θ2, θ3} {
+		if math.IsNaN(θ) {
+			t.Errorf("Curv(%v) angle %d = NaN, want a finite angle", z, i)
+		}
+	}
+}
@@ -0,0 +1,234 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package quat
+
+import (
+	"math/big"
+	"strings"
+)
+
+var zeroHR = NewHamiltonRat(
+	big.NewRat(0, 1), big.NewRat(0, 1), big.NewRat(0, 1), big.NewRat(0, 1),
+)
+
+// A HamiltonRat represents a Hamilton quaternion with big.Rat components,
+// trading the transcendental and curvilinear operations available on
+// Hamilton for exact rational arithmetic.
+type HamiltonRat [4]big.Rat
+
+// A returns a pointer to the real component of z.
+func (z *HamiltonRat) A() *big.Rat { return &z[0] }
+
+// B returns a pointer to the i component of z.
+func (z *HamiltonRat) B() *big.Rat { return &z[1] }
+
+// C returns a pointer to the j component of z.
+func (z *HamiltonRat) C() *big.Rat { return &z[2] }
+
+// D returns a pointer to the k component of z.
+func (z *HamiltonRat) D() *big.Rat { return &z[3] }
+
+// NewHamiltonRat returns a pointer to a HamiltonRat value made from four
+// given *big.Rat values.
+func NewHamiltonRat(a, b, c, d *big.Rat) *HamiltonRat {
+	z := new(HamiltonRat)
+	z.A().Set(a)
+	z.B().Set(b)
+	z.C().Set(c)
+	z.D().Set(d)
+	return z
+}
+
+// String returns the string representation of a HamiltonRat value. If z
+// corresponds to the Hamilton quaternion a + bi + cj + dk, then the string
+// is "(a+bi+cj+dk)", similar to Hamilton.String.
+func (z *HamiltonRat) String() string {
+	v := [4]*big.Rat{z.A(), z.B(), z.C(), z.D()}
+	a := make([]string, 9)
+	a[0] = "("
+	a[1] = v[0].RatString()
+	i := 1
+	for j := 2; j < 8; j = j + 2 {
+		if v[i].Sign() < 0 {
+			a[j] = v[i].RatString()
+		} else {
+			a[j] = "+" + v[i].RatString()
+		}
+		a[j+1] = symbHamilton[i]
+		i++
+	}
+	a[8] = ")"
+	return strings.Join(a, "")
+}
+
+// Equals returns true if y and z are equal.
+func (z *HamiltonRat) Equals(y *HamiltonRat) bool {
+	for i := range z {
+		if z[i].Cmp(&y[i]) != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Copy copies y onto z, and returns z.
+func (z *HamiltonRat) Copy(y *HamiltonRat) *HamiltonRat {
+	for i := range z {
+		z[i].Set(&y[i])
+	}
+	return z
+}
+
+// Scal sets z equal to y scaled by a, and returns z.
+//
+// Unlike Hamilton.Scal, there is no complex-rational scalar type in this
+// package, so Scal and Dil coincide for HamiltonRat.
+func (z *HamiltonRat) Scal(y *HamiltonRat, a *big.Rat) *HamiltonRat {
+	for i := range z {
+		z[i].Mul(&y[i], a)
+	}
+	return z
+}
+
+// Dil sets z equal to the dilation of y by a, and returns z.
+func (z *HamiltonRat) Dil(y *HamiltonRat, a *big.Rat) *HamiltonRat {
+	return z.Scal(y, a)
+}
+
+// Neg sets z equal to the negative of y, and returns z.
+func (z *HamiltonRat) Neg(y *HamiltonRat) *HamiltonRat {
+	return z.Scal(y, big.NewRat(-1, 1))
+}
+
+// Conj sets z equal to the conjugate of y, and returns z.
+func (z *HamiltonRat) Conj(y *HamiltonRat) *HamiltonRat {
+	z.A().Set(y.A())
+	z.B().Neg(y.B())
+	z.C().Neg(y.C())
+	z.D().Neg(y.D())
+	return z
+}
+
+// Add sets z equal to the sum of x and y, and returns z.
+func (z *HamiltonRat) Add(x, y *HamiltonRat) *HamiltonRat {
+	for i := range z {
+		z[i].Add(&x[i], &y[i])
+	}
+	return z
+}
+
+// Sub sets z equal to the difference of x and y, and returns z.
+func (z *HamiltonRat) Sub(x, y *HamiltonRat) *HamiltonRat {
+	for i := range z {
+		z[i].Sub(&x[i], &y[i])
+	}
+	return z
+}
+
+// Mul sets z equal to the product of x and y, and returns z.
+//
+// The multiplication rule is the same as for Hamilton.Mul.
+func (z *HamiltonRat) Mul(x, y *HamiltonRat) *HamiltonRat {
+	p := new(HamiltonRat).Copy(x)
+	q := new(HamiltonRat).Copy(y)
+	a0, a1, a2, a3 := p.A(), p.B(), p.C(), p.D()
+	b0, b1, b2, b3 := q.A(), q.B(), q.C(), q.D()
+
+	var re, im1, im2, im3, t big.Rat
+
+	re.Mul(a0, b0)
+	t.Mul(a1, b1)
+	re.Sub(&re, &t)
+	t.Mul(a2, b2)
+	re.Sub(&re, &t)
+	t.Mul(a3, b3)
+	re.Sub(&re, &t)
+
+	im1.Mul(a0, b1)
+	t.Mul(a1, b0)
+	im1.Add(&im1, &t)
+	t.Mul(a2, b3)
+	im1.Add(&im1, &t)
+	t.Mul(a3, b2)
+	im1.Sub(&im1, &t)
+
+	im2.Mul(a0, b2)
+	t.Mul(a1, b3)
+	im2.Sub(&im2, &t)
+	t.Mul(a2, b0)
+	im2.Add(&im2, &t)
+	t.Mul(a3, b1)
+	im2.Add(&im2, &t)
+
+	im3.Mul(a0, b3)
+	t.Mul(a1, b2)
+	im3.Add(&im3, &t)
+	t.Mul(a2, b1)
+	im3.Sub(&im3, &t)
+	t.Mul(a3, b0)
+	im3.Add(&im3, &t)
+
+	z.A().Set(&re)
+	z.B().Set(&im1)
+	z.C().Set(&im2)
+	z.D().Set(&im3)
+	return z
+}
+
+// Commutator sets z equal to the commutator of x and y, and returns z.
+func (z *HamiltonRat) Commutator(x, y *HamiltonRat) *HamiltonRat {
+	return z.Sub(new(HamiltonRat).Mul(x, y), new(HamiltonRat).Mul(y, x))
+}
+
+// Quad returns the non-negative quadrance of z, a *big.Rat.
+func (z *HamiltonRat) Quad() *big.Rat {
+	q := new(big.Rat)
+	var t big.Rat
+	for i := range z {
+		t.Mul(&z[i], &z[i])
+		q.Add(q, &t)
+	}
+	return q
+}
+
+// Inv sets z equal to the inverse of y, and returns z. If y is zero, then
+// Inv panics.
+func (z *HamiltonRat) Inv(y *HamiltonRat) *HamiltonRat {
+	if y.Equals(zeroHR) {
+		panic("inverse of zero")
+	}
+	a := new(big.Rat).Inv(y.Quad())
+	return z.Scal(new(HamiltonRat).Conj(y), a)
+}
+
+// Quo sets z equal to the quotient of x and y, and returns z. If y is
+// zero, then Quo panics.
+func (z *HamiltonRat) Quo(x, y *HamiltonRat) *HamiltonRat {
+	if y.Equals(zeroHR) {
+		panic("denominator is zero")
+	}
+	a := new(big.Rat).Inv(y.Quad())
+	return z.Scal(new(HamiltonRat).Mul(x, new(HamiltonRat).Conj(y)), a)
+}
+
+// ToFloat returns a pointer to the Hamilton value that approximates z as
+// float64 components.
+func (z *HamiltonRat) ToFloat() *Hamilton {
+	a, _ := z.A().Float64()
+	b, _ := z.B().Float64()
+	c, _ := z.C().Float64()
+	d, _ := z.D().Float64()
+	return NewHamilton(a, b, c, d)
+}
+
+// SetFromFloat sets z equal to a rational approximation of y, and returns
+// z.
+func (z *HamiltonRat) SetFromFloat(y *Hamilton) *HamiltonRat {
+	a, b, c, d := y.Cartesian()
+	z.A().SetFloat64(a)
+	z.B().SetFloat64(b)
+	z.C().SetFloat64(c)
+	z.D().SetFloat64(d)
+	return z
+}
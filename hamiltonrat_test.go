@@ -0,0 +1,72 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package quat
+
+import (
+	"math/big"
+	"testing"
+)
+
+func ratHamilton(a, b, c, d int64) *HamiltonRat {
+	return NewHamiltonRat(
+		big.NewRat(a, 1), big.NewRat(b, 1), big.NewRat(c, 1), big.NewRat(d, 1),
+	)
+}
+
+func TestHamiltonRatMulMatchesHamilton(t *testing.T) {
+	x := ratHamilton(1, 2, 3, 4)
+	y := ratHamilton(-2, 1, 0, 3)
+
+	got := new(HamiltonRat).Mul(x, y).ToFloat()
+	want := new(Hamilton).Mul(x.ToFloat(), y.ToFloat())
+	if !hamiltonCloseEnough(got, want) {
+		t.Errorf("HamiltonRat.Mul(x, y).ToFloat() = %v, want %v", got, want)
+	}
+}
+
+func TestHamiltonRatInvRoundTrip(t *testing.T) {
+	x := ratHamilton(1, 2, 3, 4)
+	got := new(HamiltonRat).Inv(new(HamiltonRat).Inv(x))
+	if !got.Equals(x) {
+		t.Errorf("Inv(Inv(x)) = %v, want %v", got, x)
+	}
+}
+
+func TestHamiltonRatInvPanicsOnZero(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Inv(zero) did not panic")
+		}
+	}()
+	new(HamiltonRat).Inv(zeroHR)
+}
+
+func TestHamiltonRatCommutator(t *testing.T) {
+	i := ratHamilton(0, 1, 0, 0)
+	j := ratHamilton(0, 0, 1, 0)
+	k := ratHamilton(0, 0, 0, 1)
+
+	got := new(HamiltonRat).Commutator(i, j)
+	want := new(HamiltonRat).Scal(k, big.NewRat(2, 1))
+	if !got.Equals(want) {
+		t.Errorf("Commutator(i, j) = %v, want %v", got, want)
+	}
+}
+
+func TestHamiltonRatQuad(t *testing.T) {
+	x := ratHamilton(1, 2, 2, 0)
+	got := x.Quad()
+	want := big.NewRat(9, 1)
+	if got.Cmp(want) != 0 {
+		t.Errorf("Quad(x) = %v, want %v", got, want)
+	}
+}
+
+func TestHamiltonRatFloatRoundTrip(t *testing.T) {
+	y := NewHamilton(1.5, -2.25, 0.5, 3)
+	got := new(HamiltonRat).SetFromFloat(y).ToFloat()
+	if !hamiltonCloseEnough(got, y) {
+		t.Errorf("SetFromFloat(y).ToFloat() = %v, want %v", got, y)
+	}
+}
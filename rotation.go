@@ -0,0 +1,156 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package quat
+
+import "math"
+
+// Dot returns the Euclidean dot product of z and y, treating each as a
+// 4-vector of its Cartesian components.
+func (z *Hamilton) Dot(y *Hamilton) float64 {
+	za, zb, zc, zd := z.Cartesian()
+	ya, yb, yc, yd := y.Cartesian()
+	return za*ya + zb*yb + zc*yc + zd*yd
+}
+
+// Unit sets z equal to y normalized to unit quadrance, and returns z. If y
+// is zero, then Unit sets z to zero, since zero has no well-defined
+// direction to normalize to.
+func (z *Hamilton) Unit(y *Hamilton) *Hamilton {
+	if y.Equals(zeroH) {
+		return z.Copy(zeroH)
+	}
+	return z.Dil(y, 1/math.Sqrt(y.Quad()))
+}
+
+// RotationFromAxisAngle returns a pointer to a unit Hamilton quaternion
+// representing a right-handed rotation by angle radians about axis, which
+// need not be normalized. If axis is the zero vector, the identity
+// rotation is returned.
+func RotationFromAxisAngle(axis [3]float64, angle float64) *Hamilton {
+	x, y, z := axis[0], axis[1], axis[2]
+	n := math.Sqrt(x*x + y*y + z*z)
+	if n == 0 {
+		return NewHamilton(1, 0, 0, 0)
+	}
+	x, y, z = x/n, y/n, z/n
+	s, c := math.Sin(angle/2), math.Cos(angle/2)
+	return NewHamilton(c, x*s, y*s, z*s)
+}
+
+// AxisAngle returns the axis and angle of the rotation represented by the
+// unit quaternion z. If z has no vector part, the axis defaults to the
+// z-axis, since any axis is equally valid for a zero or full-turn
+// rotation.
+func (z *Hamilton) AxisAngle() (axis [3]float64, angle float64) {
+	a, b, c, d := z.Cartesian()
+	s := math.Sqrt(b*b + c*c + d*d)
+	angle = 2 * math.Atan2(s, a)
+	if s == 0 {
+		return [3]float64{0, 0, 1}, angle
+	}
+	return [3]float64{b / s, c / s, d / s}, angle
+}
+
+// Rotate returns the image of the point v under the rotation represented
+// by the unit quaternion z, computed as q·v·q⁻¹ on the pure-vector
+// quaternion built from v.
+func (z *Hamilton) Rotate(v [3]float64) [3]float64 {
+	p := NewHamilton(0, v[0], v[1], v[2])
+	inv := new(Hamilton).Inv(z)
+	r := new(Hamilton).Mul(z, new(Hamilton).Mul(p, inv))
+	_, x, y, zc := r.Cartesian()
+	return [3]float64{x, y, zc}
+}
+
+// RotationMatrix returns the 3×3 row-major rotation matrix corresponding
+// to the unit quaternion z.
+func (z *Hamilton) RotationMatrix() [9]float64 {
+	w, x, y, zc := z.Cartesian()
+	xx, yy, zz := x*x, y*y, zc*zc
+	xy, xz, yz := x*y, x*zc, y*zc
+	wx, wy, wz := w*x, w*y, w*zc
+	return [9]float64{
+		1 - 2*(yy+zz), 2 * (xy - wz), 2 * (xz + wy),
+		2 * (xy + wz), 1 - 2*(xx+zz), 2 * (yz - wx),
+		2 * (xz - wy), 2 * (yz + wx), 1 - 2*(xx+yy),
+	}
+}
+
+// FromRotationMatrix returns a pointer to the unit Hamilton quaternion
+// corresponding to the given 3×3 row-major rotation matrix m, using
+// Shepperd's method: the largest of 1+m00+m11+m22, 1+m00-m11-m22,
+// 1-m00+m11-m22, and 1-m00-m11+m22 (each four times the square of one
+// component) gives that component by a square root, and the other three
+// are then derived from off-diagonal differences, avoiding the sign
+// ambiguity of a direct square root.
+func FromRotationMatrix(m [9]float64) *Hamilton {
+	m00, m01, m02 := m[0], m[1], m[2]
+	m10, m11, m12 := m[3], m[4], m[5]
+	m20, m21, m22 := m[6], m[7], m[8]
+
+	trace := m00 + m11 + m22
+	switch {
+	case trace > m00 && trace > m11 && trace > m22:
+		s := 0.5 / math.Sqrt(trace+1)
+		return NewHamilton(
+			0.25/s,
+			(m21-m12)*s,
+			(m02-m20)*s,
+			(m10-m01)*s,
+		)
+	case m00 > m11 && m00 > m22:
+		s := 2 * math.Sqrt(1+m00-m11-m22)
+		return NewHamilton(
+			(m21-m12)/s,
+			0.25*s,
+			(m01+m10)/s,
+			(m02+m20)/s,
+		)
+	case m11 > m22:
+		s := 2 * math.Sqrt(1+m11-m00-m22)
+		return NewHamilton(
+			(m02-m20)/s,
+			(m01+m10)/s,
+			0.25*s,
+			(m12+m21)/s,
+		)
+	default:
+		s := 2 * math.Sqrt(1+m22-m00-m11)
+		return NewHamilton(
+			(m10-m01)/s,
+			(m02+m20)/s,
+			(m12+m21)/s,
+			0.25*s,
+		)
+	}
+}
+
+// Slerp returns the spherical linear interpolation between the unit
+// quaternions a and b at t ∈ [0, 1], taking the short arc between them
+// (negating b first if Dot(a, b) < 0).
+//
+// When a and b are nearly parallel, Slerp falls back to a normalized
+// linear interpolation, since the spherical formula suffers from
+// catastrophic cancellation as sin Ω → 0.
+func Slerp(a, b *Hamilton, t float64) *Hamilton {
+	d := a.Dot(b)
+	bb := new(Hamilton).Copy(b)
+	if d < 0 {
+		bb.Neg(bb)
+		d = -d
+	}
+	if d > 0.9995 {
+		z := new(Hamilton).Add(
+			new(Hamilton).Dil(a, 1-t),
+			new(Hamilton).Dil(bb, t),
+		)
+		return z.Unit(z)
+	}
+	Ω := math.Acos(d)
+	sinΩ := math.Sin(Ω)
+	return new(Hamilton).Add(
+		new(Hamilton).Dil(a, math.Sin((1-t)*Ω)/sinΩ),
+		new(Hamilton).Dil(bb, math.Sin(t*Ω)/sinΩ),
+	)
+}
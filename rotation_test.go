@@ -0,0 +1,113 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package quat
+
+import (
+	"math"
+	"testing"
+)
+
+func TestUnit(t *testing.T) {
+	y := NewHamilton(0, 3, 4, 0)
+	got := new(Hamilton).Unit(y)
+	if !closeEnough(got.Quad(), 1) {
+		t.Errorf("Unit(%v).Quad() = %v, want 1", y, got.Quad())
+	}
+}
+
+func TestUnitOfZero(t *testing.T) {
+	got := new(Hamilton).Unit(zeroH)
+	if !got.Equals(zeroH) {
+		t.Errorf("Unit(zero) = %v, want zero", got)
+	}
+}
+
+func TestRotationFromAxisAngleIsUnit(t *testing.T) {
+	q := RotationFromAxisAngle([3]float64{1, 1, 1}, math.Pi/3)
+	if !closeEnough(q.Quad(), 1) {
+		t.Errorf("RotationFromAxisAngle(...).Quad() = %v, want 1", q.Quad())
+	}
+}
+
+func TestAxisAngleRoundTrip(t *testing.T) {
+	axis := [3]float64{0, 1, 0}
+	angle := math.Pi / 4
+	q := RotationFromAxisAngle(axis, angle)
+
+	gotAxis, gotAngle := q.AxisAngle()
+	if !closeEnough(gotAngle, angle) {
+		t.Errorf("AxisAngle() angle = %v, want %v", gotAngle, angle)
+	}
+	for i := range axis {
+		if !closeEnough(gotAxis[i], axis[i]) {
+			t.Errorf("AxisAngle() axis = %v, want %v", gotAxis, axis)
+			break
+		}
+	}
+}
+
+func TestRotate(t *testing.T) {
+	q := RotationFromAxisAngle([3]float64{0, 0, 1}, math.Pi/2)
+	got := q.Rotate([3]float64{1, 0, 0})
+	want := [3]float64{0, 1, 0}
+	for i := range want {
+		if !closeEnough(got[i], want[i]) {
+			t.Errorf("Rotate((1,0,0)) = %v, want %v", got, want)
+			break
+		}
+	}
+}
+
+func TestRotationMatrixFromRotationMatrixRoundTrip(t *testing.T) {
+	q := RotationFromAxisAngle([3]float64{1, 2, 3}, 1.234)
+	m := q.RotationMatrix()
+	got := FromRotationMatrix(m)
+
+	if !hamiltonCloseEnough(got, q) {
+		if !hamiltonCloseEnough(got, new(Hamilton).Neg(q)) {
+			t.Errorf("FromRotationMatrix(q.RotationMatrix()) = %v, want %v (up to sign)", got, q)
+		}
+	}
+}
+
+func TestRotationMatrixMatchesRotate(t *testing.T) {
+	q := RotationFromAxisAngle([3]float64{1, -1, 2}, 0.7)
+	m := q.RotationMatrix()
+
+	v := [3]float64{1, 0, 0}
+	want := q.Rotate(v)
+	got := [3]float64{
+		m[0]*v[0] + m[1]*v[1] + m[2]*v[2],
+		m[3]*v[0] + m[4]*v[1] + m[5]*v[2],
+		m[6]*v[0] + m[7]*v[1] + m[8]*v[2],
+	}
+	for i := range want {
+		if !closeEnough(got[i], want[i]) {
+			t.Errorf("RotationMatrix applied to v = %v, want Rotate(v) = %v", got, want)
+			break
+		}
+	}
+}
+
+func TestSlerpEndpoints(t *testing.T) {
+	a := RotationFromAxisAngle([3]float64{0, 0, 1}, 0)
+	b := RotationFromAxisAngle([3]float64{0, 0, 1}, math.Pi/2)
+
+	if got := Slerp(a, b, 0); !hamiltonCloseEnough(got, a) {
+		t.Errorf("Slerp(a, b, 0) = %v, want %v", got, a)
+	}
+	if got := Slerp(a, b, 1); !hamiltonCloseEnough(got, b) {
+		t.Errorf("Slerp(a, b, 1) = %v, want %v", got, b)
+	}
+}
+
+func TestSlerpMidpointIsUnit(t *testing.T) {
+	a := RotationFromAxisAngle([3]float64{1, 0, 0}, 0)
+	b := RotationFromAxisAngle([3]float64{1, 0, 0}, math.Pi)
+
+	got := Slerp(a, b, 0.5)
+	if !closeEnough(got.Quad(), 1) {
+		t.Errorf("Slerp(a, b, 0.5).Quad() = %v, want 1", got.Quad())
+	}
+}
@@ -0,0 +1,158 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package quat
+
+import "math"
+
+// Phase returns the phase (argument) of z, the angle between z and its
+// scalar part, in analogy with cmplx.Phase.
+func (z *Hamilton) Phase() float64 {
+	a, b, c, d := z.Cartesian()
+	v := math.Sqrt(b*b + c*c + d*d)
+	return math.Atan2(v, a)
+}
+
+// Exp sets z equal to e**y, the base-e exponential of y, and returns z.
+//
+// For q = a + v, with v the vector part and θ = |v|, this uses the
+// reduction
+// 		exp(q) = e**a · (cos θ + (v/θ)·sin θ)
+// which agrees with cmplx.Exp when v lies along a single imaginary axis.
+// As θ → 0, the v/θ factor is taken to vanish, so exp(q) → e**a.
+func (z *Hamilton) Exp(y *Hamilton) *Hamilton {
+	a, b, c, d := y.Cartesian()
+	θ := math.Sqrt(b*b + c*c + d*d)
+	ea := math.Exp(a)
+	if θ == 0 {
+		z.SetRe(complex(ea, 0))
+		z.SetIm(0)
+		return z
+	}
+	s := ea * math.Sin(θ) / θ
+	z.SetRe(complex(ea*math.Cos(θ), b*s))
+	z.SetIm(complex(c*s, d*s))
+	return z
+}
+
+// Log sets z equal to the natural logarithm of y, and returns z.
+//
+// For q = a + v, with v the vector part, θ = |v|, and r = |q|, this uses
+// 		log(q) = ln r + (v/θ)·acos(a/r)
+// As θ → 0, the v/θ factor is taken to vanish, so log(q) → ln a for a ≥ 0.
+// For a < 0 the axis is ambiguous (any unit vector works), so, following
+// the same convention cmplx.Sqrt uses for negative reals (cmplx.Sqrt(-1)
+// = +i), Log picks the i axis: log(q) → ln|a| + iπ.
+func (z *Hamilton) Log(y *Hamilton) *Hamilton {
+	a, b, c, d := y.Cartesian()
+	θ := math.Sqrt(b*b + c*c + d*d)
+	r := math.Sqrt(y.Quad())
+	if θ == 0 {
+		if a < 0 {
+			z.SetRe(complex(math.Log(r), math.Pi))
+			z.SetIm(0)
+			return z
+		}
+		z.SetRe(complex(math.Log(r), 0))
+		z.SetIm(0)
+		return z
+	}
+	φ := math.Acos(a/r) / θ
+	z.SetRe(complex(math.Log(r), b*φ))
+	z.SetIm(complex(c*φ, d*φ))
+	return z
+}
+
+// Sqrt sets z equal to the square root of y, and returns z.
+func (z *Hamilton) Sqrt(y *Hamilton) *Hamilton {
+	if y.Equals(zeroH) {
+		return z.Copy(zeroH)
+	}
+	return z.Pow(y, 0.5)
+}
+
+// Pow sets z equal to y**p, the p-th power of y in the sense of
+// exp(p·log(y)), and returns z.
+func (z *Hamilton) Pow(y *Hamilton, p float64) *Hamilton {
+	if y.Equals(zeroH) {
+		if p == 0 {
+			return z.Copy(oneH)
+		}
+		return z.Copy(zeroH)
+	}
+	l := new(Hamilton).Log(y)
+	l.Dil(l, p)
+	return z.Exp(l)
+}
+
+// Sin sets z equal to the sine of y, and returns z.
+//
+// For q = a + v, with v the vector part and θ = |v|, this uses
+// 		sin(q) = sin(a)cosh(θ) + cos(a)(v/θ)sinh(θ)
+func (z *Hamilton) Sin(y *Hamilton) *Hamilton {
+	a, b, c, d := y.Cartesian()
+	θ := math.Sqrt(b*b + c*c + d*d)
+	if θ == 0 {
+		z.SetRe(complex(math.Sin(a), 0))
+		z.SetIm(0)
+		return z
+	}
+	s := math.Cos(a) * math.Sinh(θ) / θ
+	z.SetRe(complex(math.Sin(a)*math.Cosh(θ), b*s))
+	z.SetIm(complex(c*s, d*s))
+	return z
+}
+
+// Cos sets z equal to the cosine of y, and returns z.
+//
+// For q = a + v, with v the vector part and θ = |v|, this uses
+// 		cos(q) = cos(a)cosh(θ) - sin(a)(v/θ)sinh(θ)
+func (z *Hamilton) Cos(y *Hamilton) *Hamilton {
+	a, b, c, d := y.Cartesian()
+	θ := math.Sqrt(b*b + c*c + d*d)
+	if θ == 0 {
+		z.SetRe(complex(math.Cos(a), 0))
+		z.SetIm(0)
+		return z
+	}
+	s := -math.Sin(a) * math.Sinh(θ) / θ
+	z.SetRe(complex(math.Cos(a)*math.Cosh(θ), b*s))
+	z.SetIm(complex(c*s, d*s))
+	return z
+}
+
+// Sinh sets z equal to the hyperbolic sine of y, and returns z.
+//
+// For q = a + v, with v the vector part and θ = |v|, this uses
+// 		sinh(q) = sinh(a)cos(θ) + cosh(a)(v/θ)sin(θ)
+func (z *Hamilton) Sinh(y *Hamilton) *Hamilton {
+	a, b, c, d := y.Cartesian()
+	θ := math.Sqrt(b*b + c*c + d*d)
+	if θ == 0 {
+		z.SetRe(complex(math.Sinh(a), 0))
+		z.SetIm(0)
+		return z
+	}
+	s := math.Cosh(a) * math.Sin(θ) / θ
+	z.SetRe(complex(math.Sinh(a)*math.Cos(θ), b*s))
+	z.SetIm(complex(c*s, d*s))
+	return z
+}
+
+// Cosh sets z equal to the hyperbolic cosine of y, and returns z.
+//
+// For q = a + v, with v the vector part and θ = |v|, this uses
+// 		cosh(q) = cosh(a)cos(θ) + sinh(a)(v/θ)sin(θ)
+func (z *Hamilton) Cosh(y *Hamilton) *Hamilton {
+	a, b, c, d := y.Cartesian()
+	θ := math.Sqrt(b*b + c*c + d*d)
+	if θ == 0 {
+		z.SetRe(complex(math.Cosh(a), 0))
+		z.SetIm(0)
+		return z
+	}
+	s := math.Sinh(a) * math.Sin(θ) / θ
+	z.SetRe(complex(math.Cosh(a)*math.Cos(θ), b*s))
+	z.SetIm(complex(c*s, d*s))
+	return z
+}
@@ -0,0 +1,105 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package quat
+
+import (
+	"math"
+	"math/cmplx"
+	"testing"
+)
+
+const epsilon = 1e-6
+
+func closeEnough(a, b float64) bool {
+	return math.Abs(a-b) < epsilon
+}
+
+func hamiltonCloseEnough(z, y *Hamilton) bool {
+	za, zb, zc, zd := z.Cartesian()
+	ya, yb, yc, yd := y.Cartesian()
+	return closeEnough(za, ya) && closeEnough(zb, yb) &&
+		closeEnough(zc, yc) && closeEnough(zd, yd)
+}
+
+func TestExpLogRoundTrip(t *testing.T) {
+	qs := []*Hamilton{
+		NewHamilton(1, 2, 3, 4),
+		NewHamilton(0.5, -1, 2, -3),
+		NewHamilton(3, 0, 0, 0),
+	}
+	for _, q := range qs {
+		got := new(Hamilton).Exp(new(Hamilton).Log(q))
+		if !hamiltonCloseEnough(got, q) {
+			t.Errorf("Exp(Log(%v)) = %v, want %v", q, got, q)
+		}
+	}
+}
+
+func TestSqrtSquared(t *testing.T) {
+	qs := []*Hamilton{
+		NewHamilton(1, 2, 3, 4),
+		NewHamilton(-1, 0, 0, 0),
+		NewHamilton(-4, 0, 0, 0),
+		NewHamilton(0.5, -1, 2, -3),
+	}
+	for _, q := range qs {
+		s := new(Hamilton).Sqrt(q)
+		got := new(Hamilton).Mul(s, s)
+		if !hamiltonCloseEnough(got, q) {
+			t.Errorf("Sqrt(%v)^2 = %v, want %v", q, got, q)
+		}
+	}
+}
+
+func TestLogNegativeRealPicksIAxis(t *testing.T) {
+	q := NewHamilton(-1, 0, 0, 0)
+	got := new(Hamilton).Log(q)
+	want := NewHamilton(0, math.Pi, 0, 0)
+	if !hamiltonCloseEnough(got, want) {
+		t.Errorf("Log(%v) = %v, want %v", q, got, want)
+	}
+}
+
+func TestExpConsistentWithCmplx(t *testing.T) {
+	cases := []complex128{2 + 3i, -1 + 0.5i, 0.25 - 1.5i}
+	for _, c := range cases {
+		q := NewHamilton(real(c), imag(c), 0, 0)
+		got := new(Hamilton).Exp(q)
+		want := cmplx.Exp(c)
+		ga, gb, _, _ := got.Cartesian()
+		if !closeEnough(ga, real(want)) || !closeEnough(gb, imag(want)) {
+			t.Errorf("Exp(%v) = (%v, %v), want cmplx.Exp = %v", q, ga, gb, want)
+		}
+	}
+}
+
+func TestSinCosConsistentWithCmplx(t *testing.T) {
+	cases := []complex128{1 + 2i, -0.5 + 1.5i}
+	for _, c := range cases {
+		q := NewHamilton(real(c), imag(c), 0, 0)
+
+		gotSin := new(Hamilton).Sin(q)
+		wantSin := cmplx.Sin(c)
+		sa, sb, _, _ := gotSin.Cartesian()
+		if !closeEnough(sa, real(wantSin)) || !closeEnough(sb, imag(wantSin)) {
+			t.Errorf("Sin(%v) = (%v, %v), want cmplx.Sin = %v", q, sa, sb, wantSin)
+		}
+
+		gotCos := new(Hamilton).Cos(q)
+		wantCos := cmplx.Cos(c)
+		ca, cb, _, _ := gotCos.Cartesian()
+		if !closeEnough(ca, real(wantCos)) || !closeEnough(cb, imag(wantCos)) {
+			t.Errorf("Cos(%v) = (%v, %v), want cmplx.Cos = %v", q, ca, cb, wantCos)
+		}
+	}
+}
+
+func TestPowMatchesSqrt(t *testing.T) {
+	q := NewHamilton(1, 2, 3, 4)
+	got := new(Hamilton).Pow(q, 0.5)
+	want := new(Hamilton).Sqrt(q)
+	if !hamiltonCloseEnough(got, want) {
+		t.Errorf("Pow(%v, 0.5) = %v, want %v", q, got, want)
+	}
+}